@@ -1,5 +1,10 @@
 package poller
 
+import (
+	"reflect"
+	"time"
+)
+
 // Option represents a configurable value passed to a Poller instantiation.
 type Option func(*Poller) error
 
@@ -34,3 +39,102 @@ func SetOnError(fn OnError) Option {
 		return nil
 	}
 }
+
+// SetPollTimeout bounds how long a single Poll call, spawned by Start, is
+// allowed to run. Once the timeout elapses the context passed to the getter
+// and pushers is canceled. A zero value, the default, leaves Poll unbounded.
+func SetPollTimeout(v time.Duration) Option {
+	return func(p *Poller) error {
+		p.pollTimeout = v
+
+		return nil
+	}
+}
+
+// SetOverlapPolicy controls what Start does when a tick fires while the
+// previous Poll call is still running. See [Policy] for the available
+// behaviors. The default is [Concurrent]. This has no effect when [SetBackoff]
+// is also set: computing the next delay requires knowing whether the
+// previous tick failed, so Start always waits for it before scheduling the
+// next one, regardless of the configured Policy.
+func SetOverlapPolicy(v Policy) Option {
+	return func(p *Poller) error {
+		p.overlapPolicy = v
+
+		return nil
+	}
+}
+
+// SetBackoff enables exponential backoff with jitter on consecutive [Getter]
+// failures. Instead of always waiting the fixed interval, Start waits
+// min(max, initial * factor^(N-1)) after the Nth consecutive failure,
+// multiplied by a uniform random factor in [1-jitter, 1+jitter]. The delay
+// resets to interval on the first successful Getter call.
+//
+// Since the next delay depends on whether the tick that just ran failed,
+// enabling SetBackoff makes Start wait for each tick to finish before
+// scheduling the next one, overriding [SetOverlapPolicy] even if it was set
+// to [Concurrent].
+func SetBackoff(initial, max time.Duration, factor, jitter float64) Option {
+	return func(p *Poller) error {
+		p.backoffEnabled = true
+		p.backoffInitial = initial
+		p.backoffMax = max
+		p.backoffFactor = factor
+		p.backoffJitter = jitter
+
+		return nil
+	}
+}
+
+// SetChangeDetector configures eq to compare each new value returned by the
+// getter against the last successful one. When eq reports the values equal,
+// Poll skips running the pushers for that call and reports Event.Changed as
+// false to subscribers. See also [SetChangeDetectorDeepEqual].
+func SetChangeDetector(eq func(prev, next interface{}) bool) Option {
+	return func(p *Poller) error {
+		p.changeDetector = eq
+
+		return nil
+	}
+}
+
+// SetChangeDetectorDeepEqual is a convenience for [SetChangeDetector] that
+// compares values with reflect.DeepEqual.
+func SetChangeDetectorDeepEqual() Option {
+	return func(p *Poller) error {
+		p.changeDetector = func(prev, next interface{}) bool {
+			return reflect.DeepEqual(prev, next)
+		}
+
+		return nil
+	}
+}
+
+// SetCircuitBreaker wraps the getter in a three-state breaker (Closed, Open,
+// HalfOpen). After failureThreshold consecutive getter errors the breaker
+// opens: Poll short-circuits with [ErrCircuitOpen] without calling the
+// getter or any pusher. Once openDuration has elapsed it moves to HalfOpen
+// and lets up to halfOpenProbes real getter calls through, closing again on
+// success or reopening, with the timer reset, on failure.
+func SetCircuitBreaker(failureThreshold int, openDuration time.Duration, halfOpenProbes int) Option {
+	return func(p *Poller) error {
+		p.breakerEnabled = true
+		p.breakerThreshold = failureThreshold
+		p.breakerOpenDuration = openDuration
+		p.breakerHalfOpenProbes = halfOpenProbes
+
+		return nil
+	}
+}
+
+// SetOnStateChange registers a function called whenever the circuit breaker
+// configured with [SetCircuitBreaker] transitions between states, so
+// operators can log or alert on breaker trips.
+func SetOnStateChange(fn func(from, to State)) Option {
+	return func(p *Poller) error {
+		p.onStateChange = fn
+
+		return nil
+	}
+}
@@ -3,17 +3,36 @@
 // Polling is actively retrieving data in a set interval. The operation is
 // divided in two sets: get the data and optionally push the data.
 //
-// For retrieval, a [Getter] is passed and it'll be called by a ticker at a set
-// interval. The [Getter] can return information or an error. In case of error,
-// the [OnError] is called.
+// A [Poller] is created with [New] and configured with one or more [Option].
+// [Poller.Start] launches it: it's non-blocking, running the poll loop on an
+// internal goroutine on a resettable timer rather than a plain [time.Ticker],
+// so the delay between ticks can change between calls, which [SetBackoff]
+// relies on. [Poller.Stop] requests shutdown and [Poller.Wait] blocks until every
+// in-flight call, including the one driving the loop, has returned. The
+// lifecycle is one-shot: a stopped [Poller] can't be restarted.
+//
+// For retrieval, a [Getter] is passed and it's called on every tick. The
+// [Getter] can return information or an error. In case of error, the
+// [OnError] is called; consecutive errors can trip [SetBackoff]'s growing
+// delay and, past a threshold, [SetCircuitBreaker]'s open state, which
+// short-circuits further ticks until it recovers.
 //
 // For transformation, one or multiple [Pusher] can be passed. If at least one
 // has been passed, they are executed in sequential order, as they've been
 // passed. The information returned by the [Getter] is passed as input and if
-// any error is returned, [OnError] is called.
+// any error is returned, [OnError] is called. If [SetChangeDetector] or
+// [SetChangeDetectorDeepEqual] was set, pushers only run when the new value
+// differs from the last successful one.
 //
 // Each [Pusher] is independent, which means if one fails, the others are still
-// going to be executed and won't be interrupted.
+// going to be executed and won't be interrupted. A [Getter] or [Pusher] that
+// panics is recovered and reported to [OnError] as a [PanicError] instead of
+// taking down the poll loop.
+//
+// Every Poll call also publishes an [Event] to the channels returned by
+// [Poller.Subscribe], including one short-circuited by an open circuit
+// breaker. A tick dropped under the [Skip] overlap policy makes no Poll call
+// and publishes no Event.
 //
 //	                              ┌──────────────┐
 //	                              │ p.onError()  │
@@ -21,8 +40,8 @@
 //	        │                     └──────────────┘       │
 //	        ▼                            ▲               │
 //	┌──────────────┐      ┌──────────────┤      ┌─────────────────┐
-//	│ ticker waits │      │  p.getter()  │      │ p.pushers slice │
-//	│   interval   │─────▶│    called    │─────▶│    called in    │
+//	│  timer waits │      │  p.getter()  │      │ p.pushers slice │
+//	│    delay     │─────▶│    called    │─────▶│    called in    │
 //	└──────────────┘      └──────────────┘      │    sequence     │
 //	        │                                   └─────────────────┘
 //	        │                                            │
@@ -34,7 +53,14 @@ package poller
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"math"
+	"math/rand"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -42,12 +68,155 @@ import (
 // met, be it a specific conditional or a timeout. It's initialized with [New]
 // and configurable passing one or multiple [Option].
 type Poller struct {
-	interval int64
-	getter   Getter
-	pushers  []Pusher
-	onError  OnError
+	interval      int64
+	getter        Getter
+	pushers       []Pusher
+	onError       OnError
+	pollTimeout   time.Duration
+	overlapPolicy Policy
+	inFlight      chan struct{}
+
+	backoffEnabled      bool
+	backoffInitial      time.Duration
+	backoffMax          time.Duration
+	backoffFactor       float64
+	backoffJitter       float64
+	consecutiveFailures int
+
+	changeDetector func(prev, next interface{}) bool
+	changeState    *pollState
+
+	breakerEnabled        bool
+	breakerThreshold      int
+	breakerOpenDuration   time.Duration
+	breakerHalfOpenProbes int
+	onStateChange         func(from, to State)
+	breaker               *breakerState
+
+	life *lifecycle
+}
+
+// State represents a [Poller]'s circuit-breaker state, set with
+// [SetCircuitBreaker].
+type State int
+
+const (
+	// Closed is the normal state: the getter is called on every tick.
+	Closed State = iota
+	// Open short-circuits Poll: neither the getter nor the pushers are
+	// called, and onError receives [ErrCircuitOpen] instead.
+	Open
+	// HalfOpen allows a limited number of probe calls through to the
+	// getter to decide whether to close the breaker again or reopen it.
+	HalfOpen
+)
+
+// breakerState holds the mutable circuit-breaker state, guarded by mu since
+// [Poller.Poll] may run overlapping calls under [Concurrent]. It's allocated
+// once in [New] and referenced by pointer for the same reason as pollState.
+type breakerState struct {
+	mu              sync.Mutex
+	state           State
+	failures        int
+	openUntil       time.Time
+	probesRemaining int
+}
+
+// lifecycle holds the state backing Start, Stop and Wait. It's allocated
+// once in [New] and referenced by pointer for the same reason as pollState:
+// every copy of a Poller must observe the same run state without copying the
+// sync primitives themselves.
+type lifecycle struct {
+	state  atomic.Int32
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// run states tracked by lifecycle.state. A Poller starts statePending,
+// transitions to stateRunning on a successful Start, and to stateStopped on
+// a successful Stop; both transitions are one-way.
+const (
+	statePending int32 = iota
+	stateRunning
+	stateStopped
+)
+
+// pollState holds the mutable state shared by every copy of a Poller: the
+// last successfully fetched value, used by the change detector, and the
+// subscriber channels registered with [Poller.Subscribe]. It's allocated
+// once in [New] and referenced by pointer so copies of Poller, such as the
+// value returned by New and any copy made of it, still observe the same state.
+type pollState struct {
+	mu        sync.Mutex
+	hasLast   bool
+	lastValue interface{}
+	subs      []chan Event
 }
 
+// Event represents the outcome of a single Poll call, delivered to every
+// channel returned by [Poller.Subscribe]. Changed is false for an event
+// carrying an Err. Otherwise it's true unless a change detector was set with
+// [SetChangeDetector] or [SetChangeDetectorDeepEqual] and Value compared
+// equal to the previous successful result.
+type Event struct {
+	Value     interface{}
+	Err       error
+	Timestamp time.Time
+	Changed   bool
+}
+
+// Policy controls how Start behaves when a tick fires while the previous
+// Poll call is still running. It's set with [SetOverlapPolicy].
+type Policy int
+
+const (
+	// Concurrent lets every tick spawn its own Poll call, regardless of
+	// whether a previous call is still running. This is the default and
+	// matches the historical behavior of Start. It has no effect if
+	// [SetBackoff] is also set; see SetBackoff.
+	Concurrent Policy = iota
+	// Skip drops a tick if the previous Poll call hasn't finished yet,
+	// notifying onError with [ErrPollSkipped] instead of running it.
+	Skip
+	// Queue blocks a tick until the previous Poll call has finished,
+	// serializing every invocation.
+	Queue
+)
+
+// ErrPollSkipped is passed to onError when a tick is dropped because the
+// previous Poll call is still running and the [Poller] was configured with
+// [SetOverlapPolicy] set to [Skip].
+var ErrPollSkipped = errors.New("poller: poll skipped, previous call still in flight")
+
+// ErrAlreadyStarted is returned by Start when it's called on a [Poller]
+// that's already running.
+var ErrAlreadyStarted = errors.New("poller: already started")
+
+// ErrAlreadyStopped is returned by Start when it's called on a [Poller]
+// that was previously stopped with Stop. A Poller's lifecycle is one-shot:
+// once stopped, it can't be restarted.
+var ErrAlreadyStopped = errors.New("poller: already stopped")
+
+// ErrNotStarted is returned by Stop when it's called on a [Poller] that was
+// never started.
+var ErrNotStarted = errors.New("poller: not started")
+
+// PanicError is the error passed to onError when a [Getter] or [Pusher]
+// panics. Value holds the recovered value and Stack the stack trace
+// captured at the point of the panic.
+type PanicError struct {
+	Value interface{}
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("poller: recovered panic: %v\n%s", e.Value, e.Stack)
+}
+
+// ErrCircuitOpen is passed to onError when [Poller.Poll] short-circuits because the
+// circuit breaker configured with [SetCircuitBreaker] is open.
+var ErrCircuitOpen = errors.New("poller: circuit breaker open")
+
 // Getter returns a value and an error. Getter is used as a template for a
 // getter function passed to a [Poller] initialization. The [Poller] instance
 // will use Getter to update the status of itself.
@@ -75,9 +244,13 @@ func defaultOnError(_ context.Context, err error) {
 // Option values passed. It'll return an error
 func New(g Getter, opts ...Option) (Poller, error) {
 	p := Poller{ // default values
-		interval: 30000, // 30s
-		getter:   g,
-		onError:  defaultOnError,
+		interval:    30000, // 30s
+		getter:      g,
+		onError:     defaultOnError,
+		inFlight:    make(chan struct{}, 1),
+		changeState: &pollState{},
+		breaker:     &breakerState{},
+		life:        &lifecycle{},
 	}
 
 	for _, opt := range opts {
@@ -89,37 +262,347 @@ func New(g Getter, opts ...Option) (Poller, error) {
 	return p, nil
 }
 
-// Start begins the polling mechanism in the set interval. This is a blocking
-// call. Use the context passed to cancel this call.
-func (p Poller) Start(ctx context.Context) {
-	ticker := time.NewTicker(time.Duration(p.interval) * time.Millisecond)
+// Start begins the polling mechanism in the set interval. It's non-blocking:
+// it launches an internal goroutine and returns immediately. A Poller's
+// lifecycle is one-shot and can't be restarted: Start returns
+// [ErrAlreadyStarted] if the [Poller] is already running, and
+// [ErrAlreadyStopped] if it was previously stopped with Stop. Use Stop to
+// request shutdown and Wait to block until every in-flight Poll call,
+// including the one backing the internal goroutine, has returned.
+func (p *Poller) Start(ctx context.Context) error {
+	if !p.life.state.CompareAndSwap(statePending, stateRunning) {
+		if p.life.state.Load() == stateStopped {
+			return ErrAlreadyStopped
+		}
+
+		return ErrAlreadyStarted
+	}
+
+	p.life.stopCh = make(chan struct{})
+
+	p.life.wg.Add(1)
+	go p.run(ctx)
+
+	return nil
+}
+
+// Stop requests the goroutine started by Start to shut down. It doesn't wait
+// for in-flight Poll calls to finish; call Wait for that. It returns
+// [ErrNotStarted] if the [Poller] was never started.
+func (p *Poller) Stop() error {
+	if !p.life.state.CompareAndSwap(stateRunning, stateStopped) {
+		return ErrNotStarted
+	}
+
+	close(p.life.stopCh)
+
+	return nil
+}
+
+// Wait blocks until the goroutine started by Start, and every Poll call it
+// launched, have returned.
+func (p *Poller) Wait() {
+	p.life.wg.Wait()
+}
+
+// run is the loop launched by Start. It waits on a resettable timer instead
+// of a [time.Ticker] so the delay between ticks can grow and shrink when
+// [SetBackoff] is configured.
+func (p *Poller) run(ctx context.Context) {
+	defer p.life.wg.Done()
+
+	delay := time.Duration(p.interval) * time.Millisecond
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
 
 	for {
 		select {
 		case <-ctx.Done():
-			ticker.Stop()
 			return
-		case <-ticker.C:
-			go p.Poll(ctx)
+		case <-p.life.stopCh:
+			return
+		case <-timer.C:
+			if p.backoffEnabled {
+				done := make(chan error, 1)
+
+				p.life.wg.Add(1)
+				go func() {
+					defer p.life.wg.Done()
+					p.tick(ctx, done)
+				}()
+
+				select {
+				case err := <-done:
+					delay = p.afterTick(err)
+				case <-ctx.Done():
+					return
+				case <-p.life.stopCh:
+					return
+				}
+			} else {
+				p.life.wg.Add(1)
+				go func() {
+					defer p.life.wg.Done()
+					p.tick(ctx, nil)
+				}()
+			}
+
+			timer.Reset(delay)
 		}
 	}
 }
 
+// afterTick updates the consecutive-failure count from the result of a tick
+// and returns the delay the next one should wait for, applying backoff when
+// err isn't nil.
+func (p *Poller) afterTick(err error) time.Duration {
+	if err == nil {
+		p.consecutiveFailures = 0
+		return time.Duration(p.interval) * time.Millisecond
+	}
+
+	p.consecutiveFailures++
+
+	d := float64(p.backoffInitial) * math.Pow(p.backoffFactor, float64(p.consecutiveFailures-1))
+	if max := float64(p.backoffMax); d > max {
+		d = max
+	}
+
+	jitter := 1 + (rand.Float64()*2-1)*p.backoffJitter
+
+	return time.Duration(d * jitter)
+}
+
+// tick runs a single Poll call honoring the configured pollTimeout and
+// overlapPolicy. It's the unit of work Start launches on every tick. When
+// done isn't nil, the error returned by Poll (nil on success) is sent to it
+// once the call finishes, or nil if the tick was skipped, so Start can react
+// to it.
+func (p *Poller) tick(ctx context.Context, done chan<- error) {
+	switch p.overlapPolicy {
+	case Skip:
+		select {
+		case p.inFlight <- struct{}{}:
+			defer func() { <-p.inFlight }()
+		default:
+			p.onError(ctx, ErrPollSkipped)
+			if done != nil {
+				done <- nil
+			}
+			return
+		}
+	case Queue:
+		p.inFlight <- struct{}{}
+		defer func() { <-p.inFlight }()
+	case Concurrent:
+		// no guard, every tick runs independently.
+	}
+
+	if p.pollTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.pollTimeout)
+		defer cancel()
+	}
+
+	err := p.Poll(ctx)
+	if done != nil {
+		done <- err
+	}
+}
+
 // Poll calls the [Poller] getter and pushers, if the getter succeeds. Each
 // pusher is called independently and if one pusher errors out it wont cancel
 // the other one. All the pushers are called. On error, p.onError will be
-// called for both, the getter and the pushers.
-func (p Poller) Poll(ctx context.Context) {
-	gr, err := p.getter(ctx)
+// called for both, the getter and the pushers. The error returned is the one
+// produced by the getter, if any, and is used internally by Start to drive
+// [SetBackoff]; pusher errors aren't reflected in it.
+//
+// Every call also publishes an [Event] to the channels returned by
+// [Poller.Subscribe]. If a change detector was set with [SetChangeDetector]
+// or [SetChangeDetectorDeepEqual], pushers are only run, and Event.Changed is
+// only true, when the new value differs from the last successful one.
+//
+// If [SetCircuitBreaker] was used and the breaker is open, Poll returns
+// [ErrCircuitOpen] without calling the getter or any pusher.
+func (p *Poller) Poll(ctx context.Context) error {
+	if p.breakerEnabled && !p.breakerAllow() {
+		p.onError(ctx, ErrCircuitOpen)
+		p.publish(Event{Err: ErrCircuitOpen, Timestamp: time.Now()})
+		return ErrCircuitOpen
+	}
+
+	gr, err := p.callGetter(ctx)
+	now := time.Now()
 	if err != nil {
+		if p.breakerEnabled {
+			p.breakerRecord(err)
+		}
 		p.onError(ctx, err)
-		return
+		p.publish(Event{Err: err, Timestamp: now})
+		return err
+	}
+
+	if p.breakerEnabled {
+		p.breakerRecord(nil)
+	}
+
+	changed := true
+	if p.changeDetector != nil {
+		p.changeState.mu.Lock()
+		if p.changeState.hasLast && p.changeDetector(p.changeState.lastValue, gr) {
+			changed = false
+		}
+		p.changeState.lastValue = gr
+		p.changeState.hasLast = true
+		p.changeState.mu.Unlock()
+	}
+
+	p.publish(Event{Value: gr, Timestamp: now, Changed: changed})
+
+	if !changed {
+		return nil
 	}
 
 	for _, pp := range p.pushers {
-		err := pp(ctx, gr)
+		err := p.callPusher(ctx, pp, gr)
 		if err != nil {
 			p.onError(ctx, err)
 		}
 	}
+
+	return nil
+}
+
+// callGetter invokes the getter, recovering from a panic and turning it into
+// a [PanicError] so a single bad Getter can't take down the goroutine
+// launched by Start.
+func (p *Poller) callGetter(ctx context.Context) (gr interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	return p.getter(ctx)
+}
+
+// callPusher invokes a single pusher, recovering from a panic the same way
+// callGetter does. Since each pusher is called independently, a recovered
+// panic doesn't stop the remaining ones from running.
+func (p *Poller) callPusher(ctx context.Context, pp Pusher, gr interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	return pp(ctx, gr)
+}
+
+// Subscribe returns a channel that receives an [Event] for every Poll call
+// made from this point on. The channel is buffered with room for a single
+// pending event; if a subscriber isn't keeping up, newer events are dropped
+// rather than blocking Poll.
+func (p *Poller) Subscribe() <-chan Event {
+	ch := make(chan Event, 1)
+
+	p.changeState.mu.Lock()
+	p.changeState.subs = append(p.changeState.subs, ch)
+	p.changeState.mu.Unlock()
+
+	return ch
+}
+
+// publish delivers ev to every subscriber registered with Subscribe,
+// dropping it for subscribers that aren't ready to receive.
+func (p *Poller) publish(ev Event) {
+	p.changeState.mu.Lock()
+	subs := p.changeState.subs
+	p.changeState.mu.Unlock()
+
+	for _, ch := range subs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+// breakerAllow reports whether Poll may proceed to call the getter, moving
+// the breaker from Open to HalfOpen first when due. In Open state it flips to
+// HalfOpen once openDuration has elapsed and grants up to halfOpenProbes
+// calls.
+func (p *Poller) breakerAllow() bool {
+	b := p.breaker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == Open {
+		if time.Now().Before(b.openUntil) {
+			return false
+		}
+
+		p.transitionBreakerLocked(b, HalfOpen)
+		b.probesRemaining = p.breakerHalfOpenProbes
+	}
+
+	if b.state == HalfOpen {
+		if b.probesRemaining <= 0 {
+			return false
+		}
+
+		b.probesRemaining--
+
+		return true
+	}
+
+	return true
+}
+
+// breakerRecord updates the breaker with the outcome of a getter call that
+// breakerAllow let through: err resets it to Closed on success, counts
+// toward failureThreshold in Closed, and reopens it immediately from
+// HalfOpen.
+func (p *Poller) breakerRecord(err error) {
+	b := p.breaker
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err == nil {
+		b.failures = 0
+		if b.state != Closed {
+			p.transitionBreakerLocked(b, Closed)
+		}
+		return
+	}
+
+	switch b.state {
+	case Closed:
+		b.failures++
+		if b.failures >= p.breakerThreshold {
+			p.openBreakerLocked(b)
+		}
+	case HalfOpen:
+		p.openBreakerLocked(b)
+	}
+}
+
+// openBreakerLocked transitions b to Open and schedules when it may move to
+// HalfOpen. Callers must hold b.mu.
+func (p *Poller) openBreakerLocked(b *breakerState) {
+	b.failures = 0
+	b.openUntil = time.Now().Add(p.breakerOpenDuration)
+	p.transitionBreakerLocked(b, Open)
+}
+
+// transitionBreakerLocked moves b to the given state, notifying
+// onStateChange if it was set and the state actually changes. Callers must
+// hold b.mu.
+func (p *Poller) transitionBreakerLocked(b *breakerState, to State) {
+	from := b.state
+	b.state = to
+
+	if p.onStateChange != nil && from != to {
+		p.onStateChange(from, to)
+	}
 }
@@ -3,7 +3,9 @@ package poller
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestNew(t *testing.T) {
@@ -209,3 +211,332 @@ func TestPoll_Poll(t *testing.T) {
 		})
 	}
 }
+
+func TestStart_OverlapPolicySkip(t *testing.T) {
+	// Several tick goroutines can hit onError concurrently here, so rather
+	// than share the single-goroutine errorCapturer, collect the errors on
+	// a channel: it's safe for concurrent sends and needs no locking.
+	var calls int32
+	errs := make(chan error, 100)
+
+	p, err := New(func(context.Context) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(30 * time.Millisecond)
+		return nil, nil
+	}, SetInterval(10), SetOverlapPolicy(Skip), SetOnError(func(_ context.Context, err error) {
+		errs <- err
+	}))
+	if err != nil {
+		t.Fatalf("on initialization: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := p.Start(ctx); err != nil {
+		t.Fatalf("on Start: %v", err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if err := p.Stop(); err != nil {
+		t.Fatalf("on Stop: %v", err)
+	}
+	p.Wait()
+	close(errs)
+
+	var sawSkipped bool
+	for err := range errs {
+		if err != ErrPollSkipped {
+			t.Errorf("expected %v, got %v", ErrPollSkipped, err)
+			continue
+		}
+		sawSkipped = true
+	}
+	if !sawSkipped {
+		t.Error("expected at least one skipped tick while overlapping")
+	}
+	if atomic.LoadInt32(&calls) >= 10 {
+		t.Errorf("expected some ticks to be skipped, but the getter ran on every one of %d ticks", calls)
+	}
+}
+
+func TestPoll_Timeout(t *testing.T) {
+	capturer := &errorCapturer{}
+
+	nn, err := New(func(ctx context.Context) (interface{}, error) {
+		select {
+		case <-time.After(50 * time.Millisecond):
+			return nil, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}, SetOnError(capturer.onError), SetPollTimeout(10*time.Millisecond))
+	if err != nil {
+		t.Fatalf("on initialization: %v", err)
+	}
+
+	nn.tick(context.Background(), nil)
+
+	if !errors.Is(capturer.err, context.DeadlineExceeded) {
+		t.Errorf("expected %v, got %v", context.DeadlineExceeded, capturer.err)
+	}
+}
+
+func TestPoller_Backoff(t *testing.T) {
+	p, err := New(nil, SetInterval(100), SetBackoff(10*time.Millisecond, 200*time.Millisecond, 2, 0))
+	if err != nil {
+		t.Fatalf("on initialization: %v", err)
+	}
+
+	failErr := errors.New("getter failed")
+
+	d1 := p.afterTick(failErr)
+	d2 := p.afterTick(failErr)
+	d3 := p.afterTick(failErr)
+
+	if d1 != 10*time.Millisecond || d2 != 20*time.Millisecond || d3 != 40*time.Millisecond {
+		t.Errorf("expected growing delays 10ms/20ms/40ms, got %v/%v/%v", d1, d2, d3)
+	}
+
+	if d4 := p.afterTick(nil); d4 != 100*time.Millisecond {
+		t.Errorf("expected delay to reset to interval after a success, got %v", d4)
+	}
+}
+
+func TestPoller_BackoffCapsAtMax(t *testing.T) {
+	p, err := New(nil, SetInterval(100), SetBackoff(10*time.Millisecond, 25*time.Millisecond, 2, 0))
+	if err != nil {
+		t.Fatalf("on initialization: %v", err)
+	}
+
+	failErr := errors.New("getter failed")
+
+	p.afterTick(failErr) // 10ms
+	p.afterTick(failErr) // 20ms
+	d := p.afterTick(failErr)
+
+	if d != 25*time.Millisecond {
+		t.Errorf("expected delay capped at 25ms, got %v", d)
+	}
+}
+
+func TestPoller_BackoffOverridesOverlapPolicy(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	p, err := New(func(context.Context) (interface{}, error) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			cur := atomic.LoadInt32(&maxInFlight)
+			if n <= cur || atomic.CompareAndSwapInt32(&maxInFlight, cur, n) {
+				break
+			}
+		}
+
+		time.Sleep(30 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+
+		return nil, errors.New("getter failed")
+	},
+		SetInterval(10),
+		SetOverlapPolicy(Concurrent),
+		SetBackoff(5*time.Millisecond, 20*time.Millisecond, 2, 0),
+	)
+	if err != nil {
+		t.Fatalf("on initialization: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := p.Start(ctx); err != nil {
+		t.Fatalf("on Start: %v", err)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if err := p.Stop(); err != nil {
+		t.Fatalf("on Stop: %v", err)
+	}
+	p.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got > 1 {
+		t.Errorf("expected SetBackoff to serialize ticks despite Concurrent, saw %d in flight at once", got)
+	}
+}
+
+func TestPoll_ChangeDetectorAndSubscribe(t *testing.T) {
+	values := []int{1, 1, 2}
+	i := 0
+	pusherCalls := 0
+
+	nn, err := New(func(context.Context) (interface{}, error) {
+		v := values[i]
+		i++
+		return v, nil
+	},
+		SetChangeDetectorDeepEqual(),
+		SetPusher(func(context.Context, interface{}) error {
+			pusherCalls++
+			return nil
+		}),
+	)
+	if err != nil {
+		t.Fatalf("on initialization: %v", err)
+	}
+
+	ch := nn.Subscribe()
+
+	want := []bool{true, false, true}
+	for _, wantChanged := range want {
+		nn.Poll(context.Background())
+
+		ev := <-ch
+		if ev.Changed != wantChanged {
+			t.Errorf("expected Changed=%v, got %v", wantChanged, ev.Changed)
+		}
+	}
+
+	if pusherCalls != 2 {
+		t.Errorf("expected 2 pusher calls (repeat value suppressed), got %d", pusherCalls)
+	}
+}
+
+func TestPoll_PanicRecovery(t *testing.T) {
+	t.Run("panicking getter", func(t *testing.T) {
+		capturer := &errorCapturer{}
+
+		nn, err := New(func(context.Context) (interface{}, error) {
+			panic("boom")
+		}, SetOnError(capturer.onError))
+		if err != nil {
+			t.Fatalf("on initialization: %v", err)
+		}
+
+		nn.Poll(context.Background())
+
+		var pe *PanicError
+		if !errors.As(capturer.err, &pe) {
+			t.Fatalf("expected a *PanicError, got %v", capturer.err)
+		}
+	})
+
+	t.Run("panicking pusher doesn't stop the others", func(t *testing.T) {
+		capturer := &errorCapturer{}
+		secondCalled := false
+
+		nn, err := New(func(context.Context) (interface{}, error) {
+			return nil, nil
+		},
+			SetOnError(capturer.onError),
+			SetPusher(func(context.Context, interface{}) error {
+				panic("boom")
+			}),
+			SetPusher(func(context.Context, interface{}) error {
+				secondCalled = true
+				return nil
+			}),
+		)
+		if err != nil {
+			t.Fatalf("on initialization: %v", err)
+		}
+
+		nn.Poll(context.Background())
+
+		var pe *PanicError
+		if !errors.As(capturer.err, &pe) {
+			t.Errorf("expected a *PanicError, got %v", capturer.err)
+		}
+		if !secondCalled {
+			t.Error("expected the second pusher to run despite the first panicking")
+		}
+	})
+}
+
+func TestPoll_CircuitBreaker(t *testing.T) {
+	getterErr := errors.New("getter failed")
+	failing := true
+
+	capturer := &errorCapturer{}
+	var transitions []State
+
+	nn, err := New(func(context.Context) (interface{}, error) {
+		if failing {
+			return nil, getterErr
+		}
+
+		return nil, nil
+	},
+		SetOnError(capturer.onError),
+		SetCircuitBreaker(2, 20*time.Millisecond, 1),
+		SetOnStateChange(func(from, to State) {
+			transitions = append(transitions, to)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("on initialization: %v", err)
+	}
+
+	nn.Poll(context.Background()) // 1st failure, still Closed
+	nn.Poll(context.Background()) // 2nd failure, trips to Open
+
+	nn.Poll(context.Background()) // short-circuited, getter not called
+	if capturer.err != ErrCircuitOpen {
+		t.Fatalf("expected %v while open, got %v", ErrCircuitOpen, capturer.err)
+	}
+
+	time.Sleep(30 * time.Millisecond) // let openDuration elapse
+
+	failing = false
+	capturer.err = nil
+	nn.Poll(context.Background()) // HalfOpen probe succeeds, closes the breaker
+	if capturer.err != nil {
+		t.Fatalf("expected no error after the breaker closes, got %v", capturer.err)
+	}
+
+	want := []State{Open, HalfOpen, Closed}
+	if len(transitions) != len(want) {
+		t.Fatalf("expected transitions %v, got %v", want, transitions)
+	}
+	for i, s := range want {
+		if transitions[i] != s {
+			t.Errorf("transition %d: expected %v, got %v", i, s, transitions[i])
+		}
+	}
+}
+
+func TestPoller_Lifecycle(t *testing.T) {
+	p, err := New(func(context.Context) (interface{}, error) {
+		return nil, nil
+	}, SetInterval(10))
+	if err != nil {
+		t.Fatalf("on initialization: %v", err)
+	}
+
+	if err := p.Stop(); err != ErrNotStarted {
+		t.Errorf("expected %v stopping before Start, got %v", ErrNotStarted, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if err := p.Start(ctx); err != nil {
+		t.Fatalf("on Start: %v", err)
+	}
+
+	if err := p.Start(ctx); err != ErrAlreadyStarted {
+		t.Errorf("expected %v on double Start, got %v", ErrAlreadyStarted, err)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	if err := p.Stop(); err != nil {
+		t.Errorf("on Stop: %v", err)
+	}
+
+	p.Wait()
+
+	if err := p.Start(ctx); err != ErrAlreadyStopped {
+		t.Errorf("expected %v restarting a stopped Poller, got %v", ErrAlreadyStopped, err)
+	}
+}